@@ -0,0 +1,103 @@
+package dragonchain
+
+import "context"
+
+// BlockHeader contains metadata for a Block.
+type BlockHeader struct {
+	// BlockID is the unique ID of the block.
+	BlockID string `json:"block_id"`
+	// Level is the level of DragonChain that produced the block (L1-L5).
+	Level string `json:"level"`
+	// DragonChainID is the unique ID of the DragonChain that produced the block.
+	DragonChainID string `json:"dc_id"`
+	// Timestamp is the epoch timestamp that the block was created.
+	Timestamp string `json:"timestamp"`
+}
+
+// BlockProof contains the proof data contributed by a single DragonChain
+// level (L1-L5) that has validated a Block.
+type BlockProof struct {
+	Scheme string `json:"scheme"`
+	Proof  string `json:"proof"`
+	Nonce  int    `json:"nonce,omitempty"`
+}
+
+// Block is a single block on a DragonChain blockchain.
+type Block struct {
+	DCRN         string         `json:"dcrn"`
+	Version      string         `json:"version"`
+	Header       BlockHeader    `json:"header"`
+	Transactions []*Transaction `json:"transactions,omitempty"`
+
+	// L1-L5 contain the proof data contributed by each level of DragonChain
+	// that has validated this block so far. Lower levels are populated first;
+	// a freshly-committed L1 block will typically have only L1 set.
+	L1 *BlockProof `json:"l1-dragonchain,omitempty"`
+	L2 *BlockProof `json:"l2-dragonchain,omitempty"`
+	L3 *BlockProof `json:"l3-dragonchain,omitempty"`
+	L4 *BlockProof `json:"l4-dragonchain,omitempty"`
+	L5 *BlockProof `json:"l5-dragonchain,omitempty"`
+
+	// PrevProof is the proof of the previous block on the same DragonChain,
+	// forming the hash chain used to verify inclusion.
+	PrevProof string `json:"prev_proof"`
+}
+
+// GetBlock retrieves the block with the given id from a DragonChain.
+//
+// An error is returned if the block could not be retrieved. The error
+// will be an APIError.
+func (c *Client) GetBlock(id string) (*Block, error) {
+	return c.GetBlockContext(context.Background(), id)
+}
+
+// GetBlockContext is the context-aware variant of GetBlock.
+func (c *Client) GetBlockContext(ctx context.Context, id string) (*Block, error) {
+	var resp struct {
+		HTTPResponse
+		Response Block
+	}
+	if err := c.GetContext(ctx, "/block/"+id, &resp); err != nil {
+		return nil, &APIError{Err: err}
+	}
+	if !resp.OK {
+		return nil, &APIError{StatusCode: resp.StatusCode}
+	}
+	return &resp.Response, nil
+}
+
+// QueryBlocks queries all blocks on a DragonChain blockchain using the provided
+// QueryOptions, returning a list of Blocks that match the query.
+//
+// An error is returned if the query could not be completed. If the error is a result of the
+// HTTP request failing or returning a non-2xx status code, the returned error will be of
+// type APIError.
+func (c *Client) QueryBlocks(q *QueryOptions) ([]*Block, error) {
+	return c.QueryBlocksContext(context.Background(), q)
+}
+
+// QueryBlocksContext is the context-aware variant of QueryBlocks.
+func (c *Client) QueryBlocksContext(ctx context.Context, q *QueryOptions) ([]*Block, error) {
+	var resp struct {
+		HTTPResponse
+		Response struct {
+			Results []*Block
+		}
+	}
+	url := "/block"
+	params := luceneQueryParams(q)
+	if params != "" {
+		url += "?" + params
+	}
+	if err := c.GetContext(ctx, url, &resp); err != nil {
+		return nil, &APIError{Err: err}
+	}
+	if !resp.OK {
+		return nil, &APIError{StatusCode: resp.StatusCode}
+	}
+	results := resp.Response.Results
+	if results == nil {
+		results = make([]*Block, 0)
+	}
+	return results, nil
+}
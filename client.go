@@ -1,6 +1,9 @@
 package dragonchain
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // HTTPResponse is a base response structure that is present
 // in ever response from the DragonChain API, whether the
@@ -14,9 +17,13 @@ type HTTPResponse struct {
 // the DragonChain API server.
 type Inquirer interface {
 	Get(resource string, out interface{}) error
+	GetContext(ctx context.Context, resource string, out interface{}) error
 	Post(resource string, body []byte, out interface{}) error
+	PostContext(ctx context.Context, resource string, body []byte, out interface{}) error
 	Put(resource string, body []byte, out interface{}) error
+	PutContext(ctx context.Context, resource string, body []byte, out interface{}) error
 	Delete(resource string, out interface{}) error
+	DeleteContext(ctx context.Context, resource string, out interface{}) error
 }
 
 // APIError is an error returned from the DragonChain API.
@@ -79,4 +86,16 @@ type Client struct {
 	// Credentials are the credentials used to access a DragonChain via
 	// the DragonChain API.
 	Credentials *Credentials
+
+	// BulkConcurrency bounds how many sub-batches BulkCreateTransactions will
+	// have in flight at once when txs exceeds MaxBulkPutSize. A value <= 0
+	// behaves as 1, i.e. sub-batches are submitted serially.
+	BulkConcurrency int
+
+	// RetryBulk opts BulkCreateTransactions sub-batch submissions into the
+	// RetryPolicy. It defaults to false because a sub-batch POST is not
+	// guaranteed idempotent: a retry after a transport error or 5xx that
+	// actually reached the server can create duplicate transactions on-chain.
+	// Set this only if that risk is acceptable for your use case.
+	RetryBulk bool
 }
@@ -1,6 +1,7 @@
 package dragonchain
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -82,11 +83,16 @@ type ContractDefinition struct {
 // An error is returned if the contract could not be retrieved. The error
 // will be an APIError.
 func (c *Client) Contract(id string) (*Contract, error) {
+	return c.ContractContext(context.Background(), id)
+}
+
+// ContractContext is the context-aware variant of Contract.
+func (c *Client) ContractContext(ctx context.Context, id string) (*Contract, error) {
 	var resp struct {
 		HTTPResponse
 		Response Contract
 	}
-	if err := c.Get("/contract/"+id, &resp); err != nil {
+	if err := c.GetContext(ctx, "/contract/"+id, &resp); err != nil {
 		return nil, &APIError{Err: err}
 	}
 	if !resp.OK {
@@ -102,6 +108,11 @@ func (c *Client) Contract(id string) (*Contract, error) {
 // result of the HTTP request failing or returning a non-2xx status code, the
 // returned error will be an APIError.
 func (c *Client) QueryContracts(q *QueryOptions) ([]*Contract, error) {
+	return c.QueryContractsContext(context.Background(), q)
+}
+
+// QueryContractsContext is the context-aware variant of QueryContracts.
+func (c *Client) QueryContractsContext(ctx context.Context, q *QueryOptions) ([]*Contract, error) {
 	var resp struct {
 		HTTPResponse
 		Response struct {
@@ -113,7 +124,7 @@ func (c *Client) QueryContracts(q *QueryOptions) ([]*Contract, error) {
 	if params != "" {
 		url += "?" + params
 	}
-	if err := c.Get(url, &resp); err != nil {
+	if err := c.GetContext(ctx, url, &resp); err != nil {
 		return nil, &APIError{Err: err}
 	}
 	if !resp.OK {
@@ -137,12 +148,17 @@ func (c *Client) QueryContracts(q *QueryOptions) ([]*Contract, error) {
 // If the error is a result of the contract failing to update on the server, the error will be
 // ErrContractUpdateFailed.
 func (c *Client) UpdateContract(id string, update *ContractDefinition) error {
+	return c.UpdateContractContext(context.Background(), id, update)
+}
+
+// UpdateContractContext is the context-aware variant of UpdateContract.
+func (c *Client) UpdateContractContext(ctx context.Context, id string, update *ContractDefinition) error {
 	b, err := json.Marshal(update)
 	if err != nil {
 		return fmt.Errorf("failed to JSON marshal contract definition : %s", err)
 	}
 	var resp HTTPResponse
-	if err = c.Put("/contract/"+id, b, &resp); err != nil {
+	if err = c.PutContext(ctx, "/contract/"+id, b, &resp); err != nil {
 		return &APIError{Err: err}
 	}
 	if !resp.OK {
@@ -158,8 +174,13 @@ func (c *Client) UpdateContract(id string, update *ContractDefinition) error {
 // Otherwise, if the error is a result of the server failing to delete the contract, the error
 // will be ErrContractDeleteFailed.
 func (c *Client) DeleteContract(id string) error {
+	return c.DeleteContractContext(context.Background(), id)
+}
+
+// DeleteContractContext is the context-aware variant of DeleteContract.
+func (c *Client) DeleteContractContext(ctx context.Context, id string) error {
 	var resp HTTPResponse
-	if err := c.Delete("/contract/"+id, &resp); err != nil {
+	if err := c.DeleteContext(ctx, "/contract/"+id, &resp); err != nil {
 		return &APIError{Err: err}
 	}
 	if !resp.OK {
@@ -174,6 +195,11 @@ func (c *Client) DeleteContract(id string) error {
 // result of the HTTP request failing or returning a non-2xx status code, the
 // error will be an APIError.
 func (c *Client) CreateContract(def *ContractDefinition) error {
+	return c.CreateContractContext(context.Background(), def)
+}
+
+// CreateContractContext is the context-aware variant of CreateContract.
+func (c *Client) CreateContractContext(ctx context.Context, def *ContractDefinition) error {
 	vers, err := strconv.Atoi(def.Version)
 	if err != nil {
 		return fmt.Errorf("invalid version %s: %s", def.Version, err)
@@ -186,7 +212,7 @@ func (c *Client) CreateContract(def *ContractDefinition) error {
 		return fmt.Errorf("failed to JSON marshal contract definition: %s", err)
 	}
 	var resp HTTPResponse
-	if err = c.Post("/contract", b, &resp); err != nil {
+	if err = c.PostContext(ctx, "/contract", b, &resp); err != nil {
 		return &APIError{Err: err}
 	}
 	if !resp.OK {
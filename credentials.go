@@ -0,0 +1,124 @@
+package dragonchain
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const defaultCredentialsFile = ".dragonchain/credentials"
+
+// NewCredentialsFromEnv builds Credentials from the DRAGONCHAIN_ID, AUTH_KEY_ID,
+// and AUTH_KEY environment variables.
+//
+// An error is returned if any of the three environment variables are unset.
+func NewCredentialsFromEnv() (*Credentials, error) {
+	dragonchainID := os.Getenv("DRAGONCHAIN_ID")
+	authKeyID := os.Getenv("AUTH_KEY_ID")
+	authKey := os.Getenv("AUTH_KEY")
+	if dragonchainID == "" || authKeyID == "" || authKey == "" {
+		return nil, fmt.Errorf("dragonchain: DRAGONCHAIN_ID, AUTH_KEY_ID, and AUTH_KEY must all be set")
+	}
+	return &Credentials{
+		DragonChainID: dragonchainID,
+		APIKey:        authKey,
+		ClientID:      authKeyID,
+	}, nil
+}
+
+// NewCredentialsFromFile reads Credentials for chainID from the ini-formatted
+// credentials file at path. The file is expected to contain a section for
+// each DragonChain id, keyed by that id, with auth_key_id and auth_key keys:
+//
+//	[chainID]
+//	auth_key_id = ...
+//	auth_key = ...
+//
+// If path is empty, the standard ~/.dragonchain/credentials location is used.
+func NewCredentialsFromFile(path, chainID string) (*Credentials, error) {
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine home directory: %s", err)
+		}
+		path = filepath.Join(home, defaultCredentialsFile)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open credentials file %s: %s", path, err)
+	}
+	defer f.Close()
+	section, err := iniSection(f, chainID)
+	if err != nil {
+		return nil, err
+	}
+	authKeyID, ok := section["auth_key_id"]
+	if !ok {
+		return nil, fmt.Errorf("dragonchain: credentials file %s is missing auth_key_id for %s", path, chainID)
+	}
+	authKey, ok := section["auth_key"]
+	if !ok {
+		return nil, fmt.Errorf("dragonchain: credentials file %s is missing auth_key for %s", path, chainID)
+	}
+	return &Credentials{
+		DragonChainID: chainID,
+		APIKey:        authKey,
+		ClientID:      authKeyID,
+	}, nil
+}
+
+// ResolveCredentials attempts to build Credentials for chainID, trying, in
+// order: the DRAGONCHAIN_ID/AUTH_KEY_ID/AUTH_KEY environment variables, the
+// ~/.dragonchain/credentials ini file, and finally fallback, if provided.
+//
+// An error is returned only if none of these sources yield usable credentials.
+func ResolveCredentials(chainID string, fallback *Credentials) (*Credentials, error) {
+	if creds, err := NewCredentialsFromEnv(); err == nil {
+		return creds, nil
+	}
+	if creds, err := NewCredentialsFromFile("", chainID); err == nil {
+		return creds, nil
+	}
+	if fallback != nil {
+		return fallback, nil
+	}
+	return nil, fmt.Errorf("dragonchain: no credentials found in environment, credentials file, or fallback")
+}
+
+// iniSection scans r for the named ini section and returns its key/value pairs.
+func iniSection(r *os.File, name string) (map[string]string, error) {
+	scanner := bufio.NewScanner(r)
+	inSection := false
+	section := make(map[string]string)
+	found := false
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			inSection = strings.TrimSpace(line[1:len(line)-1]) == name
+			if inSection {
+				found = true
+			}
+			continue
+		}
+		if !inSection {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		section[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read credentials file: %s", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("dragonchain: no section for %s found in credentials file", name)
+	}
+	return section, nil
+}
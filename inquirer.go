@@ -2,6 +2,7 @@ package dragonchain
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
@@ -13,10 +14,7 @@ import (
 	"time"
 )
 
-var (
-	defaultSigningAlgo = sha256.New()
-	defaultEndpoint    = "https://api.dragonchain.com"
-)
+var defaultEndpoint = "https://api.dragonchain.com"
 
 // Credentials are the credentials used for authenticating requests to the DragonChain API.
 type Credentials struct {
@@ -28,8 +26,92 @@ type Credentials struct {
 	// ClientID is the unique client id for the credentials. This is used along with APIKey
 	// to authenticate requests.
 	ClientID string
-	// SigningAlogorithm is the hash function used in HMAC signatures.
-	SigningAlgorithm hash.Hash
+	// SigningAlgorithm constructs the hash function used in HMAC signatures.
+	// It must return a new, independent hash.Hash on each call, since
+	// crypto/hmac calls it twice to build the inner and outer pads; SHA256 is
+	// used when unset.
+	SigningAlgorithm func() hash.Hash
+}
+
+// Authenticator signs outgoing requests to the DragonChain API server.
+//
+// Sign is responsible for computing and setting the Authorization header on
+// req, using body as the (already serialized) request payload. Implementations
+// may also read other headers already set on req, such as "timestamp" and
+// "Content-type", when computing the signature.
+type Authenticator interface {
+	Sign(req *http.Request, body []byte) error
+}
+
+// HMACAuthenticator is the default Authenticator used by the SDK. It implements
+// the DC1-HMAC-SHA256 signing scheme, using Credentials.SigningAlgorithm in
+// place of SHA256 when one is provided.
+type HMACAuthenticator struct {
+	Credentials Credentials
+}
+
+// NewHMACAuthenticator returns an HMACAuthenticator that signs requests using creds.
+func NewHMACAuthenticator(creds Credentials) *HMACAuthenticator {
+	return &HMACAuthenticator{Credentials: creds}
+}
+
+// Sign satisfies the Authenticator interface.
+func (a *HMACAuthenticator) Sign(req *http.Request, body []byte) error {
+	signingAlgo := a.Credentials.SigningAlgorithm
+	if signingAlgo == nil {
+		signingAlgo = sha256.New
+	}
+	if body == nil {
+		body = []byte("")
+	}
+	sha := sha256.New()
+	sha.Write(body)
+	b64Content := base64.StdEncoding.EncodeToString(sha.Sum(nil))
+	message := fmt.Sprintf(
+		"%s\n%s\n%s\n%s\n%s\n%s",
+		strings.ToUpper(req.Method), req.URL.RequestURI(),
+		a.Credentials.DragonChainID,
+		req.Header.Get("timestamp"),
+		req.Header.Get("Content-type"),
+		b64Content,
+	)
+	mac := hmac.New(signingAlgo, []byte(a.Credentials.APIKey))
+	mac.Write([]byte(message))
+	b64Mac := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	req.Header.Set("Authorization", fmt.Sprintf("DC1-HMAC-SHA256 %s:%s", a.Credentials.ClientID, b64Mac))
+	return nil
+}
+
+// unsupportedAuthenticator is a placeholder Authenticator for hash algorithms
+// that DragonChain supports but that this SDK does not yet implement.
+type unsupportedAuthenticator struct {
+	algo string
+}
+
+// Sign satisfies the Authenticator interface. It always returns an error, since
+// the underlying hash algorithm has not been wired up yet.
+func (a *unsupportedAuthenticator) Sign(req *http.Request, body []byte) error {
+	return fmt.Errorf("dragonchain: %s authentication is not yet implemented", a.algo)
+}
+
+// NewSHA3Authenticator returns an Authenticator for DragonChain's DC1-HMAC-SHA3-256
+// signing scheme.
+//
+// This is currently a stub: it is provided so callers can select the algorithm
+// without forking the SDK, but Sign on the returned Authenticator always
+// returns an error until SHA3-256 support is implemented.
+func NewSHA3Authenticator(creds Credentials) Authenticator {
+	return &unsupportedAuthenticator{algo: "DC1-HMAC-SHA3-256"}
+}
+
+// NewBLAKE2bAuthenticator returns an Authenticator for DragonChain's DC1-HMAC-BLAKE2b512
+// signing scheme.
+//
+// This is currently a stub: it is provided so callers can select the algorithm
+// without forking the SDK, but Sign on the returned Authenticator always
+// returns an error until BLAKE2b support is implemented.
+func NewBLAKE2bAuthenticator(creds Credentials) Authenticator {
+	return &unsupportedAuthenticator{algo: "DC1-HMAC-BLAKE2b512"}
 }
 
 type httpClient interface {
@@ -39,82 +121,132 @@ type httpClient interface {
 type inquirer struct {
 	httpClient
 
-	Endpoint    string
-	Credentials Credentials
-	VerifySSL   bool
+	Endpoint      string
+	Credentials   Credentials
+	Authenticator Authenticator
+	RetryPolicy   *RetryPolicy
+	VerifySSL     bool
 }
 
-func (iq *inquirer) hmacSign(message string) []byte {
-	signingAlgo := iq.Credentials.SigningAlgorithm
-	if signingAlgo == nil {
-		signingAlgo = defaultSigningAlgo
+func (iq *inquirer) authenticator() Authenticator {
+	if iq.Authenticator != nil {
+		return iq.Authenticator
+	}
+	return &HMACAuthenticator{Credentials: iq.Credentials}
+}
+
+func (iq *inquirer) retryPolicy() RetryPolicy {
+	if iq.RetryPolicy == nil {
+		return defaultRetryPolicy
+	}
+	return iq.RetryPolicy.withDefaults()
+}
+
+// methodIsRetryable reports whether method is eligible for retries. GET, PUT,
+// and DELETE are idempotent and always eligible; POST is only eligible when
+// ctx was produced by WithRetryablePost.
+func (iq *inquirer) methodIsRetryable(ctx context.Context, method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return isRetryablePost(ctx)
+	default:
+		return false
 	}
-	h := hmac.New(func() hash.Hash { return signingAlgo }, []byte(iq.Credentials.APIKey))
-	h.Write([]byte(message))
-	return h.Sum(nil)
 }
 
+// Get satisfies the Inquirer interface.
 func (iq *inquirer) Get(resource string, out interface{}) error {
-	return iq.doRequest(http.MethodGet, resource, "application/json", nil, out)
+	return iq.GetContext(context.Background(), resource, out)
 }
 
+// GetContext is the context-aware variant of Get.
+func (iq *inquirer) GetContext(ctx context.Context, resource string, out interface{}) error {
+	return iq.doRequest(ctx, http.MethodGet, resource, "application/json", nil, out)
+}
+
+// Post satisfies the Inquirer interface.
 func (iq *inquirer) Post(resource string, body []byte, out interface{}) error {
-	return iq.doRequest(http.MethodPost, resource, "application/json", body, out)
+	return iq.PostContext(context.Background(), resource, body, out)
+}
+
+// PostContext is the context-aware variant of Post.
+func (iq *inquirer) PostContext(ctx context.Context, resource string, body []byte, out interface{}) error {
+	return iq.doRequest(ctx, http.MethodPost, resource, "application/json", body, out)
 }
 
+// Put satisfies the Inquirer interface.
 func (iq *inquirer) Put(resource string, body []byte, out interface{}) error {
-	return iq.doRequest(http.MethodPut, resource, "application/json", body, out)
+	return iq.PutContext(context.Background(), resource, body, out)
 }
 
-func (iq *inquirer) Delete(resource string) (int, error) {
-	return 0, iq.doRequest(http.MethodDelete, resource, "", nil, nil)
+// PutContext is the context-aware variant of Put.
+func (iq *inquirer) PutContext(ctx context.Context, resource string, body []byte, out interface{}) error {
+	return iq.doRequest(ctx, http.MethodPut, resource, "application/json", body, out)
 }
 
-func (iq *inquirer) doRequest(method, resource, contentType string, body []byte, out interface{}) error {
+// Delete satisfies the Inquirer interface.
+func (iq *inquirer) Delete(resource string, out interface{}) error {
+	return iq.DeleteContext(context.Background(), resource, out)
+}
+
+// DeleteContext is the context-aware variant of Delete.
+func (iq *inquirer) DeleteContext(ctx context.Context, resource string, out interface{}) error {
+	return iq.doRequest(ctx, http.MethodDelete, resource, "", nil, out)
+}
+
+func (iq *inquirer) doRequest(ctx context.Context, method, resource, contentType string, body []byte, out interface{}) error {
 	if iq.Endpoint == "" {
 		iq.Endpoint = defaultEndpoint
 	}
 	url := iq.Endpoint + resource
-	req, err := http.NewRequest(method, url, bytes.NewReader(body))
-	if err != nil {
-		return fmt.Errorf("failed to create HTTP request object: %s", err)
-	}
-	timestamp := time.Now().UTC().Format("2006-01-02T15-04-05.999999") + "Z"
-	auth := iq.authorizationHeader(method, resource, contentType, timestamp, body)
-	req.Header.Set("dragonchain", iq.Credentials.DragonChainID)
-	req.Header.Set("timestamp", timestamp)
-	req.Header.Set("Authorization", auth)
-	if contentType != "" {
-		req.Header.Set("Content-type", "application/json")
-	}
-	resp, err := iq.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("request failed: %s", err)
-	}
-	defer resp.Body.Close()
-	if out == nil {
-		return nil
-	}
-	return json.NewDecoder(resp.Body).Decode(out)
-}
+	policy := iq.retryPolicy()
+	retryable := iq.methodIsRetryable(ctx, method)
+	backoff := policy.InitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to create HTTP request object: %s", err)
+		}
+		timestamp := time.Now().UTC().Format("2006-01-02T15-04-05.999999") + "Z"
+		req.Header.Set("dragonchain", iq.Credentials.DragonChainID)
+		req.Header.Set("timestamp", timestamp)
+		if contentType != "" {
+			req.Header.Set("Content-type", contentType)
+		}
+		if err := iq.authenticator().Sign(req, body); err != nil {
+			return fmt.Errorf("failed to sign request: %s", err)
+		}
 
-func (iq *inquirer) authorizationHeader(method, resource, contentType string, timestamp string, content []byte) string {
-	if content == nil {
-		content = []byte("")
+		resp, err := iq.httpClient.Do(req)
+		if !retryable || attempt >= policy.MaxAttempts || !policy.Retryable(resp, err) {
+			if err != nil {
+				return fmt.Errorf("request failed: %s", err)
+			}
+			defer resp.Body.Close()
+			if out == nil {
+				return nil
+			}
+			return json.NewDecoder(resp.Body).Decode(out)
+		}
+
+		wait := backoff
+		if resp != nil {
+			if ra := retryAfter(resp); ra > 0 {
+				wait = ra
+			}
+			resp.Body.Close()
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(fullJitter(wait)):
+		}
+		backoff = time.Duration(float64(backoff) * policy.Multiplier)
+		if backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
 	}
-	sha := sha256.New()
-	sha.Write(content)
-	hashedContent := sha.Sum(nil)
-	b64Content := base64.StdEncoding.EncodeToString(hashedContent)
-	message := fmt.Sprintf(
-		"%s\n%s\n%s\n%s\n%s\n%s",
-		strings.ToUpper(method), resource,
-		iq.Credentials.DragonChainID,
-		timestamp,
-		contentType,
-		b64Content,
-	)
-	h := hmac.New(func() hash.Hash { return iq.Credentials.SigningAlgorithm }, []byte(iq.Credentials.APIKey))
-	h.Write([]byte(message))
-	return fmt.Sprintf("DC1-HMAC-SHA256 %s:%s", iq.Credentials.ClientID, string(h.Sum(nil)))
 }
@@ -1,6 +1,9 @@
 package dragonchain
 
-import "strconv"
+import (
+	"net/url"
+	"strconv"
+)
 
 func luceneQueryParams(q *QueryOptions) string {
 	// Default to offset 0 with a limit of 10
@@ -12,20 +15,14 @@ func luceneQueryParams(q *QueryOptions) string {
 	if q.Offset > 0 {
 		offset = q.Offset
 	}
-	queryMap := map[string]string{
-		"limit":  strconv.Itoa(limit),
-		"offset": strconv.Itoa(offset),
-	}
+	values := url.Values{}
+	values.Set("limit", strconv.Itoa(limit))
+	values.Set("offset", strconv.Itoa(offset))
 	if q.QueryString != "" {
-		queryMap["q"] = q.QueryString
+		values.Set("q", q.QueryString)
 	}
 	if q.Sort != "" {
-		queryMap["sort"] = q.Sort
-	}
-	var query string
-	for k, v := range queryMap {
-		query += k + "=" + v + "&"
+		values.Set("sort", q.Sort)
 	}
-	// We need to chop off the last '&'
-	return query[:len(query)-1]
+	return values.Encode()
 }
@@ -0,0 +1,98 @@
+// Package query provides a chainable builder for constructing Lucene query
+// strings, suitable for use as dragonchain.QueryOptions.QueryString, without
+// callers having to hand-assemble (and correctly escape) Lucene syntax
+// themselves.
+package query
+
+import (
+	"fmt"
+	"strings"
+)
+
+// specialChars are the Lucene special characters that must be backslash
+// escaped when they appear in a term or value.
+const specialChars = `+-&|!(){}[]^"~*?:\/`
+
+// Query builds a Lucene query string from a sequence of chained predicates.
+//
+// The zero value, as returned by NewQuery, is ready to use.
+type Query struct {
+	clauses []string
+}
+
+// NewQuery returns an empty Query ready to have predicates added to it.
+func NewQuery() *Query {
+	return &Query{}
+}
+
+// Term adds an exact-match predicate for field, escaping any Lucene special
+// characters found in field or value.
+func (q *Query) Term(field, value string) *Query {
+	return q.add(fmt.Sprintf("%s:%s", escape(field), escape(value)))
+}
+
+// Range adds an inclusive range predicate for field, matching documents whose
+// field value falls between from and to.
+func (q *Query) Range(field, from, to string) *Query {
+	return q.add(fmt.Sprintf("%s:[%s TO %s]", escape(field), escape(from), escape(to)))
+}
+
+// And requires that other also match, in addition to anything already added to q.
+func (q *Query) And(other *Query) *Query {
+	return q.combine("AND", other)
+}
+
+// Or requires that either q or other match.
+func (q *Query) Or(other *Query) *Query {
+	return q.combine("OR", other)
+}
+
+// Not excludes documents matched by other.
+func (q *Query) Not(other *Query) *Query {
+	if other == nil || len(other.clauses) == 0 {
+		return q
+	}
+	if len(q.clauses) == 0 {
+		q.clauses = append(q.clauses, "NOT", "("+other.String()+")")
+		return q
+	}
+	q.clauses = append(q.clauses, "AND", "NOT", "("+other.String()+")")
+	return q
+}
+
+func (q *Query) combine(op string, other *Query) *Query {
+	if other == nil || len(other.clauses) == 0 {
+		return q
+	}
+	if len(q.clauses) == 0 {
+		q.clauses = append(q.clauses, "("+other.String()+")")
+		return q
+	}
+	q.clauses = append(q.clauses, op, "("+other.String()+")")
+	return q
+}
+
+func (q *Query) add(clause string) *Query {
+	if len(q.clauses) > 0 {
+		q.clauses = append(q.clauses, "AND")
+	}
+	q.clauses = append(q.clauses, clause)
+	return q
+}
+
+// String renders the Query to a Lucene query string.
+func (q *Query) String() string {
+	return strings.Join(q.clauses, " ")
+}
+
+// escape backslash-escapes any Lucene special characters in s.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(specialChars, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
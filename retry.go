@@ -0,0 +1,111 @@
+package dragonchain
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how an inquirer retries failed requests.
+//
+// GET, PUT, and DELETE requests are retried automatically, since they are
+// idempotent. POST requests are only retried when the request's context was
+// produced by WithRetryablePost, since a POST is not inherently safe to repeat.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request will be attempted,
+	// including the initial attempt. A value <= 0 behaves as 1, i.e. no retries.
+	MaxAttempts int
+	// InitialBackoff is the base delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential backoff delay between retries. A delay
+	// taken from a response's Retry-After header is not capped.
+	MaxBackoff time.Duration
+	// Multiplier scales the backoff delay after each retry.
+	Multiplier float64
+	// Retryable decides whether a given response/error pair should be retried.
+	// resp is nil if the request failed before a response was received.
+	Retryable func(resp *http.Response, err error) bool
+}
+
+var defaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Multiplier:     2,
+	Retryable:      defaultRetryable,
+}
+
+// withDefaults returns a copy of p with any unset fields filled in from
+// defaultRetryPolicy, except MaxAttempts, which is set to 1 when unset.
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 1
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = defaultRetryPolicy.InitialBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = defaultRetryPolicy.MaxBackoff
+	}
+	if p.Multiplier <= 0 {
+		p.Multiplier = defaultRetryPolicy.Multiplier
+	}
+	if p.Retryable == nil {
+		p.Retryable = defaultRetryable
+	}
+	return p
+}
+
+// defaultRetryable retries on transport errors and on 429/5xx responses.
+func defaultRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	if resp == nil {
+		return false
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// retryAfter parses the Retry-After header on resp, if present, returning 0
+// if it is absent or unparseable.
+func retryAfter(resp *http.Response) time.Duration {
+	h := resp.Header.Get("Retry-After")
+	if h == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(h); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(h); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// fullJitter implements the "full jitter" backoff strategy: a random
+// duration between 0 and d.
+func fullJitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(d)))
+}
+
+type retryablePostKey struct{}
+
+// WithRetryablePost returns a copy of ctx that opts a POST request into the
+// inquirer's RetryPolicy. Without this, POST requests are never retried,
+// since the DragonChain API does not guarantee they are idempotent.
+func WithRetryablePost(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryablePostKey{}, true)
+}
+
+func isRetryablePost(ctx context.Context) bool {
+	retryable, _ := ctx.Value(retryablePostKey{}).(bool)
+	return retryable
+}
@@ -1,9 +1,11 @@
 package dragonchain
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"sync"
 )
 
 // Transaction represents a single transaction on a DragonChain blockchain.
@@ -66,11 +68,16 @@ type TransactionDefinition struct {
 // GetTransaction retrieves the transaction for the DragonChain with the provided id.
 // An APIError error is returned if the request failed or a non-2xx status code was returned.
 func (c *Client) GetTransaction(id string) (*Transaction, error) {
+	return c.GetTransactionContext(context.Background(), id)
+}
+
+// GetTransactionContext is the context-aware variant of GetTransaction.
+func (c *Client) GetTransactionContext(ctx context.Context, id string) (*Transaction, error) {
 	var resp struct {
 		HTTPResponse
 		Response Transaction
 	}
-	if err := c.Get("/transaction/"+id, &resp); err != nil {
+	if err := c.GetContext(ctx, "/transaction/"+id, &resp); err != nil {
 		return nil, &APIError{Err: err}
 	}
 	if !resp.OK {
@@ -79,8 +86,13 @@ func (c *Client) GetTransaction(id string) (*Transaction, error) {
 	return &resp.Response, nil
 }
 
+// createTransactionResponse is the wire format actually returned by the
+// /transaction endpoint on transaction creation. Unlike most DragonChain
+// endpoints, it is not wrapped in an "ok"/"response" envelope; the
+// transaction id is returned directly at the top level.
 type createTransactionResponse struct {
-	ID string `json:"transaction_id"`
+	DCRN          string `json:"dcrn"`
+	TransactionID string `json:"transaction_id"`
 }
 
 // CreateTransaction creates a new transaction on a DragonChain blockchain.
@@ -90,6 +102,11 @@ type createTransactionResponse struct {
 // a result of the request failing or returned a non-2xx status code, the returned
 // error will be of type APIError.
 func (c *Client) CreateTransaction(def *TransactionDefinition) (string, error) {
+	return c.CreateTransactionContext(context.Background(), def)
+}
+
+// CreateTransactionContext is the context-aware variant of CreateTransaction.
+func (c *Client) CreateTransactionContext(ctx context.Context, def *TransactionDefinition) (string, error) {
 	vers, err := strconv.Atoi(def.Version)
 	if err != nil {
 		return "", fmt.Errorf("invalid version %s: %s", def.Version, err)
@@ -101,17 +118,14 @@ func (c *Client) CreateTransaction(def *TransactionDefinition) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("failed to JSON marshal transaction object: %s", err)
 	}
-	var resp struct {
-		HTTPResponse
-		Response createTransactionResponse
-	}
-	if err = c.Post("/transaction", b, &resp); err != nil {
+	var resp createTransactionResponse
+	if err = c.PostContext(ctx, "/transaction", b, &resp); err != nil {
 		return "", &APIError{Err: err}
 	}
-	if !resp.OK {
-		return "", &APIError{StatusCode: resp.StatusCode}
+	if resp.TransactionID == "" {
+		return "", &APIError{Err: fmt.Errorf("server did not return a transaction id")}
 	}
-	return resp.Response.ID, nil
+	return resp.TransactionID, nil
 }
 
 // QueryTransactions queries all transactions on a DragonChain blockchain using the provided
@@ -121,18 +135,23 @@ func (c *Client) CreateTransaction(def *TransactionDefinition) (string, error) {
 // HTTP request failing or returning a non-2xx status code, the returned error will be of
 // type APIError.
 func (c *Client) QueryTransactions(q *QueryOptions) ([]*Transaction, error) {
+	return c.QueryTransactionsContext(context.Background(), q)
+}
+
+// QueryTransactionsContext is the context-aware variant of QueryTransactions.
+func (c *Client) QueryTransactionsContext(ctx context.Context, q *QueryOptions) ([]*Transaction, error) {
 	var resp struct {
 		HTTPResponse
 		Response struct {
 			Results []*Transaction
 		}
 	}
-	url := "/transation"
+	url := "/transaction"
 	params := luceneQueryParams(q)
 	if params != "" {
 		url += "?" + params
 	}
-	if err := c.Get(url, &resp); err != nil {
+	if err := c.GetContext(ctx, url, &resp); err != nil {
 		return nil, &APIError{Err: err}
 	}
 	if !resp.OK {
@@ -149,18 +168,109 @@ type bulkTransactionDef struct {
 	Payload []*TransactionDefinition `json:"payload"`
 }
 
-// BulkCreateTransactions creates multiple transactions in a single API request. This is more
+// MaxBulkPutSize is the maximum number of transactions that the DragonChain
+// bulk transaction endpoint will accept in a single request. BulkCreateTransactions
+// transparently splits larger slices into sub-batches of this size.
+const MaxBulkPutSize = 250
+
+// BulkResult is the aggregated outcome of a (possibly chunked) call to
+// BulkCreateTransactions.
+type BulkResult struct {
+	// IDs contains the transaction ids for every transaction that was created
+	// successfully, in the same order the transactions were submitted in.
+	IDs []string
+	// Failures contains one BulkBatchError per sub-batch that failed outright.
+	// Transactions in a failed batch are not reflected in IDs.
+	Failures []*BulkBatchError
+}
+
+// BulkBatchError describes a single sub-batch failure within a BulkResult.
+type BulkBatchError struct {
+	// Offset is the index into the original transaction slice at which the
+	// failed batch began.
+	Offset int
+	// Err is the underlying error, typically an APIError.
+	Err error
+}
+
+// Error satisfies the error interface.
+func (e *BulkBatchError) Error() string {
+	return fmt.Sprintf("batch starting at offset %d failed: %s", e.Offset, e.Err)
+}
+
+// BulkCreateTransactions creates multiple transactions in a single logical operation. This is more
 // efficient than creating a separate request for each transaction, and should be used whereever
 // possible to optimize client CPU and network performance. It can also (though not always) result
 // in lower costs when using cloud providers such as AWS or Google Cloud.
 //
-// It should be noted that the bulk creation of contracts is *not atomic* and as such, if one or more
-// contracts cannot be created, those that were created will not be rolled back. Additionally, the
-// overall request will be considered successful if at least one transaction was created successfully.
+// txs is transparently split into sub-batches of at most MaxBulkPutSize transactions, since that is
+// the most the DragonChain bulk transaction endpoint will accept in a single request. Sub-batches are
+// issued concurrently, bounded by Client.BulkConcurrency (a value <= 0 behaves as 1, i.e. serial).
 //
-// An error is returned if the operation fails. If the error is a result of the HTTP request failing or
-// a non-2xx status code being returend, the error will be of type APIError.
-func (c *Client) BulkCreateTransactions(txs []*TransactionDefinition) ([]string, error) {
+// It should be noted that the bulk creation of transactions is *not atomic* and as such, if one or more
+// transactions cannot be created, those that were created will not be rolled back. A failure in one
+// sub-batch does not prevent the others from being submitted; per-batch failures are reported in the
+// returned BulkResult's Failures field rather than as a returned error.
+//
+// An error is only returned if txs could not be split and submitted at all, such as a context
+// cancellation before any sub-batch was attempted.
+func (c *Client) BulkCreateTransactions(txs []*TransactionDefinition) (*BulkResult, error) {
+	return c.BulkCreateTransactionsContext(context.Background(), txs)
+}
+
+// BulkCreateTransactionsContext is the context-aware variant of BulkCreateTransactions.
+func (c *Client) BulkCreateTransactionsContext(ctx context.Context, txs []*TransactionDefinition) (*BulkResult, error) {
+	if len(txs) == 0 {
+		return &BulkResult{IDs: []string{}}, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	concurrency := c.BulkConcurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	var offsets []int
+	var batches [][]*TransactionDefinition
+	for offset := 0; offset < len(txs); offset += MaxBulkPutSize {
+		end := offset + MaxBulkPutSize
+		if end > len(txs) {
+			end = len(txs)
+		}
+		offsets = append(offsets, offset)
+		batches = append(batches, txs[offset:end])
+	}
+	type batchResult struct {
+		ids []string
+		err error
+	}
+	results := make([]batchResult, len(batches))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, batch := range batches {
+		wg.Add(1)
+		go func(i int, batch []*TransactionDefinition) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			ids, err := c.bulkCreateTransactionBatch(ctx, batch)
+			results[i] = batchResult{ids: ids, err: err}
+		}(i, batch)
+	}
+	wg.Wait()
+
+	result := &BulkResult{IDs: make([]string, 0, len(txs))}
+	for i, r := range results {
+		if r.err != nil {
+			result.Failures = append(result.Failures, &BulkBatchError{Offset: offsets[i], Err: r.err})
+			continue
+		}
+		result.IDs = append(result.IDs, r.ids...)
+	}
+	return result, nil
+}
+
+func (c *Client) bulkCreateTransactionBatch(ctx context.Context, txs []*TransactionDefinition) ([]string, error) {
 	payload := bulkTransactionDef{txs}
 	b, err := json.Marshal(&payload)
 	if err != nil {
@@ -172,7 +282,14 @@ func (c *Client) BulkCreateTransactions(txs []*TransactionDefinition) ([]string,
 			ID string `json:"transaction_id"`
 		}
 	}
-	if err = c.Post("/transaction_bulk", b, &resp); err != nil {
+	// A sub-batch POST is not safe to retry by default: a retry after a
+	// transport error or 5xx that actually reached the server would create
+	// duplicate transactions on-chain. Client.RetryBulk opts into retrying
+	// anyway for callers who have judged that risk acceptable.
+	if c.RetryBulk {
+		ctx = WithRetryablePost(ctx)
+	}
+	if err = c.PostContext(ctx, "/transaction_bulk", b, &resp); err != nil {
 		return nil, &APIError{Err: err}
 	}
 	if !resp.OK {
@@ -0,0 +1,99 @@
+package dragonchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	srv := httptest.NewServer(handler)
+	iq := &inquirer{
+		Endpoint:    srv.URL,
+		Credentials: Credentials{DragonChainID: "dc_id", APIKey: "key", ClientID: "client_id"},
+	}
+	iq.httpClient = srv.Client()
+	return &Client{Inquirer: iq, Credentials: &iq.Credentials}, srv
+}
+
+func TestCreateTransaction(t *testing.T) {
+	client, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/transaction" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"dcrn":"Transaction::L1::FullTransaction","transaction_id":"txn-123"}`)
+	})
+	defer srv.Close()
+
+	id, err := client.CreateTransaction(&TransactionDefinition{Version: "1", Type: "test"})
+	if err != nil {
+		t.Fatalf("CreateTransaction returned error: %s", err)
+	}
+	if id != "txn-123" {
+		t.Fatalf("expected transaction id %q, got %q", "txn-123", id)
+	}
+}
+
+func TestQueryTransactionsURL(t *testing.T) {
+	var gotPath string
+	client, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		fmt.Fprint(w, `{"ok":true,"status":200,"response":{"results":[]}}`)
+	})
+	defer srv.Close()
+
+	if _, err := client.QueryTransactions(&QueryOptions{}); err != nil {
+		t.Fatalf("QueryTransactions returned error: %s", err)
+	}
+	if gotPath != "/transaction" {
+		t.Fatalf("expected path /transaction, got %s", gotPath)
+	}
+}
+
+// TestBulkCreateTransactionsOrdering uses the same real-response-shape fixture
+// style as TestCreateTransaction to make sure chunking a bulk request above
+// MaxBulkPutSize still returns ids in the original submission order.
+func TestBulkCreateTransactionsOrdering(t *testing.T) {
+	const total = MaxBulkPutSize + 10
+
+	client, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		var payload bulkTransactionDef
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode bulk request: %s", err)
+		}
+		w.Header().Set("Content-type", "application/json")
+		fmt.Fprint(w, `{"ok":true,"status":201,"response":[`)
+		for i, tx := range payload.Payload {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"transaction_id":"id-%s"}`, tx.Tag)
+		}
+		fmt.Fprint(w, `]}`)
+	})
+	defer srv.Close()
+
+	txs := make([]*TransactionDefinition, total)
+	for i := range txs {
+		txs[i] = &TransactionDefinition{Version: "1", Type: "test", Tag: fmt.Sprintf("%d", i)}
+	}
+
+	result, err := client.BulkCreateTransactions(txs)
+	if err != nil {
+		t.Fatalf("BulkCreateTransactions returned error: %s", err)
+	}
+	if len(result.Failures) != 0 {
+		t.Fatalf("unexpected batch failures: %v", result.Failures)
+	}
+	if len(result.IDs) != total {
+		t.Fatalf("expected %d ids, got %d", total, len(result.IDs))
+	}
+	for i, id := range result.IDs {
+		want := fmt.Sprintf("id-%d", i)
+		if id != want {
+			t.Fatalf("id at index %d out of order: want %s, got %s", i, want, id)
+		}
+	}
+}